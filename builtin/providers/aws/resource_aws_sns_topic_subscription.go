@@ -1,8 +1,11 @@
 package aws
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
+	"math/rand"
+	"regexp"
 	"strings"
 
 	"github.com/hashicorp/terraform/helper/schema"
@@ -26,19 +29,6 @@ func resourceAwsSnsTopicSubscription() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 				ForceNew: false,
-				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
-					value := v.(string)
-					forbidden := []string{"email", "sms"}
-					for _, f := range forbidden {
-						if strings.Contains(value, f) {
-							errors = append(
-								errors,
-								fmt.Errorf("Unsupported protocol (%s) for SNS Topic", value),
-							)
-						}
-					}
-					return
-				},
 			},
 			"endpoint": &schema.Schema{
 				Type:     schema.TypeString,
@@ -52,16 +42,36 @@ func resourceAwsSnsTopicSubscription() *schema.Resource {
 				Default:  false,
 			},
 			"max_fetch_retries": &schema.Schema{
-				Type:     schema.TypeInt,
+				Type:       schema.TypeInt,
+				Optional:   true,
+				ForceNew:   false,
+				Default:    3,
+				Deprecated: "Use confirmation_timeout instead. If set to a non-default value while confirmation_timeout is left at \"5m\", it is translated into an equivalent confirmation_timeout.",
+			},
+			"fetch_retry_delay": &schema.Schema{
+				Type:       schema.TypeInt,
+				Optional:   true,
+				ForceNew:   false,
+				Default:    1,
+				Deprecated: "Use confirmation_timeout instead. If set to a non-default value while confirmation_timeout is left at \"5m\", it is translated into an equivalent confirmation_timeout.",
+			},
+			"wait_for_confirmation": &schema.Schema{
+				Type:     schema.TypeBool,
 				Optional: true,
 				ForceNew: false,
-				Default:  3,
+				Default:  true,
 			},
-			"fetch_retry_delay": &schema.Schema{
-				Type:     schema.TypeInt,
+			"confirmation_timeout": &schema.Schema{
+				Type:     schema.TypeString,
 				Optional: true,
 				ForceNew: false,
-				Default:  1,
+				Default:  "5m",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					if _, err := time.ParseDuration(v.(string)); err != nil {
+						errors = append(errors, fmt.Errorf("%q is not a valid duration: %s", k, err))
+					}
+					return
+				},
 			},
 			"topic_arn": &schema.Schema{
 				Type:     schema.TypeString,
@@ -69,9 +79,25 @@ func resourceAwsSnsTopicSubscription() *schema.Resource {
 				ForceNew: false,
 			},
 			"delivery_policy": &schema.Schema{
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: false,
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         false,
+				ValidateFunc:     validateSnsSubscriptionDeliveryPolicy,
+				DiffSuppressFunc: suppressEquivalentJsonDiffs,
+			},
+			"filter_policy": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         false,
+				ValidateFunc:     validateSnsSubscriptionFilterPolicy,
+				DiffSuppressFunc: suppressEquivalentJsonDiffs,
+			},
+			"redrive_policy": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         false,
+				ValidateFunc:     validateSnsSubscriptionRedrivePolicy,
+				DiffSuppressFunc: suppressEquivalentJsonDiffs,
 			},
 			"raw_message_delivery": &schema.Schema{
 				Type:     schema.TypeBool,
@@ -83,6 +109,14 @@ func resourceAwsSnsTopicSubscription() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"pending_confirmation": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"confirmation_was_authenticated": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -97,11 +131,11 @@ func resourceAwsSnsTopicSubscriptionCreate(d *schema.ResourceData, meta interfac
 	}
 
 	if output.SubscriptionArn != nil && *output.SubscriptionArn == awsSNSPendingConfirmationMessage {
-		log.Printf("[WARN] Invalid SNS Subscription, received a \"%s\" ARN", awsSNSPendingConfirmationMessage)
-		return nil
+		log.Printf("[WARN] SNS Subscription is pending confirmation by the endpoint; tracking it as \"%s\" until it confirms", awsSNSPendingConfirmationMessage)
+	} else {
+		log.Printf("New subscription ARN: %s", *output.SubscriptionArn)
 	}
 
-	log.Printf("New subscription ARN: %s", *output.SubscriptionArn)
 	d.SetId(*output.SubscriptionArn)
 
 	// Write the ARN to the 'arn' field for export
@@ -113,6 +147,8 @@ func resourceAwsSnsTopicSubscriptionCreate(d *schema.ResourceData, meta interfac
 func resourceAwsSnsTopicSubscriptionUpdate(d *schema.ResourceData, meta interface{}) error {
 	snsconn := meta.(*AWSClient).snsconn
 
+	resubscribed := false
+
 	// If any changes happened, un-subscribe and re-subscribe
 	if d.HasChange("protocol") || d.HasChange("endpoint") || d.HasChange("topic_arn") {
 		log.Printf("[DEBUG] Updating subscription %s", d.Id())
@@ -127,28 +163,69 @@ func resourceAwsSnsTopicSubscriptionUpdate(d *schema.ResourceData, meta interfac
 
 		// Re-subscribe and set id
 		output, err := subscribeToSNSTopic(d, snsconn)
+		if err != nil {
+			return fmt.Errorf("Error resubscribing to SNS topic: %s", err)
+		}
 		d.SetId(*output.SubscriptionArn)
 		d.Set("arn", *output.SubscriptionArn)
+		resubscribed = true
+
+		// The new subscription starts with none of these attributes set,
+		// regardless of which ones actually changed on this apply, so push
+		// everything configured rather than relying on d.HasChange below.
+		if d.Id() != awsSNSPendingConfirmationMessage {
+			if err := pushSnsSubscriptionAttributes(d, snsconn); err != nil {
+				return err
+			}
+		}
 	}
 
-	if d.HasChange("raw_message_delivery") {
-		_, n := d.GetChange("raw_message_delivery")
-
-		attrValue := "false"
+	// A subscription that hasn't been confirmed yet has no real ARN to
+	// target with SetSubscriptionAttributes; skip pushing attributes until
+	// Read resolves it to one. If we just resubscribed, pushSnsSubscriptionAttributes
+	// above already applied everything, so skip the per-field HasChange
+	// checks to avoid pushing twice.
+	if d.Id() != awsSNSPendingConfirmationMessage && !resubscribed {
+		if d.HasChange("delivery_policy") {
+			_, n := d.GetChange("delivery_policy")
+			if err := setSnsSubscriptionJSONAttribute(d, snsconn, "DeliveryPolicy", n.(string)); err != nil {
+				return err
+			}
+		}
 
-		if n.(bool) {
-			attrValue = "true"
+		if d.HasChange("filter_policy") {
+			_, n := d.GetChange("filter_policy")
+			if err := setSnsSubscriptionJSONAttribute(d, snsconn, "FilterPolicy", n.(string)); err != nil {
+				return err
+			}
 		}
 
-		req := &sns.SetSubscriptionAttributesInput{
-			SubscriptionArn: aws.String(d.Id()),
-			AttributeName:   aws.String("RawMessageDelivery"),
-			AttributeValue:  aws.String(attrValue),
+		if d.HasChange("redrive_policy") {
+			_, n := d.GetChange("redrive_policy")
+			if err := setSnsSubscriptionJSONAttribute(d, snsconn, "RedrivePolicy", n.(string)); err != nil {
+				return err
+			}
 		}
-		_, err := snsconn.SetSubscriptionAttributes(req)
 
-		if err != nil {
-			return fmt.Errorf("Unable to set raw message delivery attribute on subscription")
+		if d.HasChange("raw_message_delivery") {
+			_, n := d.GetChange("raw_message_delivery")
+
+			attrValue := "false"
+
+			if n.(bool) {
+				attrValue = "true"
+			}
+
+			req := &sns.SetSubscriptionAttributesInput{
+				SubscriptionArn: aws.String(d.Id()),
+				AttributeName:   aws.String("RawMessageDelivery"),
+				AttributeValue:  aws.String(attrValue),
+			}
+			_, err := snsconn.SetSubscriptionAttributes(req)
+
+			if err != nil {
+				return fmt.Errorf("Unable to set raw message delivery attribute on subscription")
+			}
 		}
 	}
 
@@ -160,6 +237,33 @@ func resourceAwsSnsTopicSubscriptionRead(d *schema.ResourceData, meta interface{
 
 	log.Printf("[DEBUG] Loading subscription %s", d.Id())
 
+	if d.Id() == awsSNSPendingConfirmationMessage {
+		d.Set("pending_confirmation", true)
+		d.Set("confirmation_was_authenticated", false)
+
+		subscription, err := findSubscriptionByNonID(d, snsconn)
+		if err != nil {
+			return err
+		}
+		if subscription == nil {
+			// Still unconfirmed; nothing more to refresh until the endpoint confirms.
+			return nil
+		}
+
+		log.Printf("[DEBUG] SNS subscription confirmed, new ARN: %s", *subscription.SubscriptionArn)
+		d.SetId(*subscription.SubscriptionArn)
+		d.Set("arn", *subscription.SubscriptionArn)
+
+		// The subscription was created (or re-subscribed by Update) with
+		// wait_for_confirmation=false, so any configured delivery_policy/
+		// filter_policy/redrive_policy/raw_message_delivery couldn't be pushed
+		// then (there was no real ARN to target yet). Push them now that one
+		// exists.
+		if err := pushSnsSubscriptionAttributes(d, snsconn); err != nil {
+			return err
+		}
+	}
+
 	attributeOutput, err := snsconn.GetSubscriptionAttributes(&sns.GetSubscriptionAttributesInput{
 		SubscriptionArn: aws.String(d.Id()),
 	})
@@ -169,11 +273,27 @@ func resourceAwsSnsTopicSubscriptionRead(d *schema.ResourceData, meta interface{
 
 	if attributeOutput.Attributes != nil && len(attributeOutput.Attributes) > 0 {
 		attrHash := attributeOutput.Attributes
-		log.Printf("[DEBUG] raw message delivery: %s", *attrHash["RawMessageDelivery"])
-		if *attrHash["RawMessageDelivery"] == "true" {
-			d.Set("raw_message_delivery", true)
-		} else {
-			d.Set("raw_message_delivery", false)
+
+		d.Set("pending_confirmation", false)
+		if v, ok := attrHash["ConfirmationWasAuthenticated"]; ok && v != nil {
+			d.Set("confirmation_was_authenticated", *v == "true")
+		}
+
+		if v, ok := attrHash["RawMessageDelivery"]; ok && v != nil {
+			log.Printf("[DEBUG] raw message delivery: %s", *v)
+			d.Set("raw_message_delivery", *v == "true")
+		}
+
+		if v, ok := attrHash["DeliveryPolicy"]; ok && v != nil {
+			d.Set("delivery_policy", *v)
+		}
+
+		if v, ok := attrHash["FilterPolicy"]; ok && v != nil {
+			d.Set("filter_policy", *v)
+		}
+
+		if v, ok := attrHash["RedrivePolicy"]; ok && v != nil {
+			d.Set("redrive_policy", *v)
 		}
 	}
 
@@ -198,6 +318,7 @@ func subscribeToSNSTopic(d *schema.ResourceData, snsconn *sns.SNS) (output *sns.
 	endpoint := d.Get("endpoint").(string)
 	topic_arn := d.Get("topic_arn").(string)
 	endpoint_auto_confirms := d.Get("endpoint_auto_confirms").(bool)
+	wait_for_confirmation := d.Get("wait_for_confirmation").(bool)
 	max_fetch_retries := d.Get("max_fetch_retries").(int)
 	fetch_retry_delay := time.Duration(d.Get("fetch_retry_delay").(int))
 
@@ -218,35 +339,264 @@ func subscribeToSNSTopic(d *schema.ResourceData, snsconn *sns.SNS) (output *sns.
 		return nil, fmt.Errorf("Error creating SNS topic: %s", err)
 	}
 
-	if strings.Contains(protocol, "http") && (output.SubscriptionArn == nil || *output.SubscriptionArn == awsSNSPendingConfirmationMessage) {
-
-		log.Printf("[DEBUG] SNS create topic subscritpion is pending so fetching the subscription list for topic : %s (%s) @ '%s'", endpoint, protocol, topic_arn)
-
-		for i := 0; i < max_fetch_retries && output.SubscriptionArn != nil && *output.SubscriptionArn == awsSNSPendingConfirmationMessage; i++ {
-
-			subscription, err := findSubscriptionByNonID(d, snsconn)
-
+	// http(s), email and email-json subscriptions aren't confirmed until the
+	// endpoint acts on the confirmation message SNS sends it; sms and the
+	// AWS-native protocols (sqs, lambda, application) come back confirmed.
+	requiresConfirmation := strings.Contains(protocol, "http") || protocol == "email" || protocol == "email-json"
+	isPending := output.SubscriptionArn == nil || *output.SubscriptionArn == awsSNSPendingConfirmationMessage
+
+	if requiresConfirmation && wait_for_confirmation && isPending {
+
+		// NOTE: the original request for this polling loop asked for it to be
+		// driven by resource.StateChangeConf. That was tried first, but
+		// StateChangeConf.WaitForState paces its own retries (PollInterval/
+		// MinTimeout/Delay) independently of whatever a StateRefreshFunc does
+		// internally, so a manual snsSubscriptionConfirmationBackoff sleep inside
+		// the refresh func stacked a second, uncoordinated backoff curve on top
+		// of it — the actual inter-poll delay was no longer rand(0, min(30s,
+		// 1s*2^attempt)) as specified. This hand-rolled loop is the deviation
+		// from that ticket's literal pseudocode; flagged back to the requester
+		// to get the ticket text updated rather than silently diverging from it.
+		log.Printf("[DEBUG] SNS create topic subscritpion is pending so polling for confirmation on topic : %s (%s) @ '%s'", endpoint, protocol, topic_arn)
+
+		timeout := confirmationTimeout(d, max_fetch_retries, fetch_retry_delay)
+		deadline := time.Now().Add(timeout)
+
+		var subscription *sns.Subscription
+		for attempt := 0; ; attempt++ {
+			subscription, err = findSubscriptionByNonID(d, snsconn)
 			if err != nil {
 				return nil, fmt.Errorf("Error fetching subscriptions for SNS topic %s: %s", topic_arn, err)
 			}
-
 			if subscription != nil {
-				output.SubscriptionArn = subscription.SubscriptionArn
 				break
 			}
+			if time.Now().After(deadline) {
+				return nil, fmt.Errorf("Endpoint (%s) did not confirm the subscription for topic %s within %s", endpoint, topic_arn, timeout)
+			}
 
-			time.Sleep(time.Second * fetch_retry_delay)
+			time.Sleep(snsSubscriptionConfirmationBackoff(attempt))
 		}
 
-		if output.SubscriptionArn == nil || *output.SubscriptionArn == awsSNSPendingConfirmationMessage {
-			return nil, fmt.Errorf("Endpoint (%s) did not autoconfirm the subscription for topic %s", endpoint, topic_arn)
-		}
+		output.SubscriptionArn = subscription.SubscriptionArn
 	}
 
 	log.Printf("[DEBUG] Created new subscription!")
 	return output, nil
 }
 
+// confirmationTimeout resolves how long to wait for a subscription to
+// confirm. confirmation_timeout is authoritative; the deprecated
+// max_fetch_retries/fetch_retry_delay knobs are only honored as a fallback
+// when they've been moved away from their defaults and confirmation_timeout
+// hasn't, so existing configurations keep behaving the way they used to.
+func confirmationTimeout(d *schema.ResourceData, max_fetch_retries int, fetch_retry_delay time.Duration) time.Duration {
+	confirmationTimeoutStr := d.Get("confirmation_timeout").(string)
+	timeout, err := time.ParseDuration(confirmationTimeoutStr)
+	if err != nil {
+		timeout = 5 * time.Minute
+	}
+
+	usingDeprecatedKnobs := max_fetch_retries != 3 || fetch_retry_delay != 1
+	if usingDeprecatedKnobs && confirmationTimeoutStr == "5m" {
+		timeout = time.Duration(max_fetch_retries) * fetch_retry_delay * time.Second
+	}
+
+	return timeout
+}
+
+// snsSubscriptionConfirmationBackoff returns the delay to wait before the
+// next confirmation poll: capped exponential backoff with full jitter
+// (delay = rand(0, min(cap, base * 2^attempt))), which converges quickly for
+// fast-confirming endpoints while avoiding a thundering herd of simultaneous
+// ListSubscriptionsByTopic calls when many subscriptions are applied at once.
+func snsSubscriptionConfirmationBackoff(attempt int) time.Duration {
+	const (
+		base     = 1 * time.Second
+		capDelay = 30 * time.Second
+	)
+
+	backoff := capDelay
+	if shifted := base * time.Duration(int64(1)<<uint(attempt)); shifted > 0 && shifted < capDelay {
+		backoff = shifted
+	}
+
+	return time.Duration(rand.Int63n(int64(backoff) + 1))
+}
+
+// pushSnsSubscriptionAttributes applies every configured optional attribute
+// to a subscription, regardless of whether it changed on this apply. Update
+// uses it right after re-subscribing, since the new subscription starts with
+// none of these set; Read uses it once a subscription created with
+// wait_for_confirmation=false resolves to a real ARN, since none of these
+// could be pushed while the ARN was still "pending confirmation".
+func pushSnsSubscriptionAttributes(d *schema.ResourceData, snsconn *sns.SNS) error {
+	if v := d.Get("delivery_policy").(string); v != "" {
+		if err := setSnsSubscriptionJSONAttribute(d, snsconn, "DeliveryPolicy", v); err != nil {
+			return err
+		}
+	}
+
+	if v := d.Get("filter_policy").(string); v != "" {
+		if err := setSnsSubscriptionJSONAttribute(d, snsconn, "FilterPolicy", v); err != nil {
+			return err
+		}
+	}
+
+	if v := d.Get("redrive_policy").(string); v != "" {
+		if err := setSnsSubscriptionJSONAttribute(d, snsconn, "RedrivePolicy", v); err != nil {
+			return err
+		}
+	}
+
+	if d.Get("raw_message_delivery").(bool) {
+		req := &sns.SetSubscriptionAttributesInput{
+			SubscriptionArn: aws.String(d.Id()),
+			AttributeName:   aws.String("RawMessageDelivery"),
+			AttributeValue:  aws.String("true"),
+		}
+		if _, err := snsconn.SetSubscriptionAttributes(req); err != nil {
+			return fmt.Errorf("Unable to set raw message delivery attribute on subscription")
+		}
+	}
+
+	return nil
+}
+
+// setSnsSubscriptionJSONAttribute pushes a JSON-valued subscription
+// attribute (e.g. DeliveryPolicy, FilterPolicy, RedrivePolicy) to SNS. An
+// empty value clears the attribute by setting it to "{}", which is the
+// API's way of removing a previously configured policy.
+func setSnsSubscriptionJSONAttribute(d *schema.ResourceData, snsconn *sns.SNS, attrName, value string) error {
+	if value == "" {
+		value = "{}"
+	}
+
+	req := &sns.SetSubscriptionAttributesInput{
+		SubscriptionArn: aws.String(d.Id()),
+		AttributeName:   aws.String(attrName),
+		AttributeValue:  aws.String(value),
+	}
+	if _, err := snsconn.SetSubscriptionAttributes(req); err != nil {
+		return fmt.Errorf("Error setting %s on SNS subscription: %s", attrName, err)
+	}
+	return nil
+}
+
+// snsSubscriptionDeliveryPolicy mirrors the subset of SNS's delivery policy
+// document that Terraform validates on plan, so a malformed policy is caught
+// before SetSubscriptionAttributes is called.
+type snsSubscriptionDeliveryPolicy struct {
+	HealthyRetryPolicy           *snsSubscriptionDeliveryPolicyHealthyRetryPolicy `json:"healthyRetryPolicy,omitempty"`
+	ThrottlePolicy               *snsSubscriptionDeliveryPolicyThrottlePolicy     `json:"throttlePolicy,omitempty"`
+	DisableSubscriptionOverrides *bool                                           `json:"disableSubscriptionOverrides,omitempty"`
+}
+
+type snsSubscriptionDeliveryPolicyHealthyRetryPolicy struct {
+	MinDelayTarget     *int    `json:"minDelayTarget,omitempty"`
+	MaxDelayTarget     *int    `json:"maxDelayTarget,omitempty"`
+	NumRetries         *int    `json:"numRetries,omitempty"`
+	NumMaxDelayRetries *int    `json:"numMaxDelayRetries,omitempty"`
+	NumMinDelayRetries *int    `json:"numMinDelayRetries,omitempty"`
+	NumNoDelayRetries  *int    `json:"numNoDelayRetries,omitempty"`
+	BackoffFunction    *string `json:"backoffFunction,omitempty"`
+}
+
+type snsSubscriptionDeliveryPolicyThrottlePolicy struct {
+	MaxReceivesPerSecond *int `json:"maxReceivesPerSecond,omitempty"`
+}
+
+func validateSnsSubscriptionDeliveryPolicy(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if value == "" {
+		return
+	}
+
+	var policy snsSubscriptionDeliveryPolicy
+	if err := json.Unmarshal([]byte(value), &policy); err != nil {
+		errors = append(errors, fmt.Errorf("%q contains an invalid JSON delivery policy: %s", k, err))
+	}
+	return
+}
+
+// snsFilterPolicyOperators are the filter-object operators SNS message
+// filtering supports, e.g. {"numeric": ["<", 100]} or {"exists": true}.
+var snsFilterPolicyOperators = map[string]bool{
+	"exists":       true,
+	"anything-but": true,
+	"numeric":      true,
+	"prefix":       true,
+}
+
+// validateSnsSubscriptionFilterPolicy checks that a filter policy is a
+// top-level JSON object whose values are arrays of plain values (strings,
+// numbers, booleans) and/or single-operator filter objects, per the SNS
+// message filtering constraints.
+func validateSnsSubscriptionFilterPolicy(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if value == "" {
+		return
+	}
+
+	var policy map[string][]interface{}
+	if err := json.Unmarshal([]byte(value), &policy); err != nil {
+		errors = append(errors, fmt.Errorf("%q contains an invalid JSON filter policy: %s", k, err))
+		return
+	}
+
+	for attribute, rules := range policy {
+		for _, rule := range rules {
+			switch r := rule.(type) {
+			case string, float64, bool:
+				// plain value match against the message attribute
+			case map[string]interface{}:
+				if len(r) != 1 {
+					errors = append(errors, fmt.Errorf("%q: filter policy attribute %q has a filter object that must contain exactly one operator", k, attribute))
+					continue
+				}
+				for operator := range r {
+					if !snsFilterPolicyOperators[operator] {
+						errors = append(errors, fmt.Errorf("%q: filter policy attribute %q uses unsupported operator %q", k, attribute, operator))
+					}
+				}
+			default:
+				errors = append(errors, fmt.Errorf("%q: filter policy attribute %q contains an unsupported rule type %T", k, attribute, rule))
+			}
+		}
+	}
+
+	return
+}
+
+// snsRedrivePolicyDeadLetterArnRegexp matches an SQS queue ARN across AWS
+// partitions (aws, aws-cn, aws-us-gov, ...).
+var snsRedrivePolicyDeadLetterArnRegexp = regexp.MustCompile(`^arn:aws[\w-]*:sqs:[a-z0-9-]+:\d{12}:[a-zA-Z0-9_.-]{1,80}$`)
+
+type snsSubscriptionRedrivePolicy struct {
+	DeadLetterTargetArn string `json:"deadLetterTargetArn"`
+}
+
+// validateSnsSubscriptionRedrivePolicy checks that a redrive policy is valid
+// JSON naming a well-formed SQS ARN as its dead-letter target.
+func validateSnsSubscriptionRedrivePolicy(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if value == "" {
+		return
+	}
+
+	var policy snsSubscriptionRedrivePolicy
+	if err := json.Unmarshal([]byte(value), &policy); err != nil {
+		errors = append(errors, fmt.Errorf("%q contains an invalid JSON redrive policy: %s", k, err))
+		return
+	}
+
+	if !snsRedrivePolicyDeadLetterArnRegexp.MatchString(policy.DeadLetterTargetArn) {
+		errors = append(errors, fmt.Errorf("%q: deadLetterTargetArn %q is not a valid SQS queue ARN", k, policy.DeadLetterTargetArn))
+	}
+
+	return
+}
+
 // finds a subscription using protocol, endpoint and topic_arn (which is a key in sns subscription)
 func findSubscriptionByNonID(d *schema.ResourceData, snsconn *sns.SNS) (*sns.Subscription, error) {
 	protocol := d.Get("protocol").(string)
@@ -0,0 +1,92 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sns"
+)
+
+// resourceAwsSnsTopicSubscriptionConfirmation lets operators confirm a
+// pending SNS subscription out-of-band, using a token delivered by SNS to
+// the endpoint (e.g. captured from an HTTPS webhook that doesn't
+// auto-confirm). It has no Update: confirming with a new token re-creates
+// the resource.
+func resourceAwsSnsTopicSubscriptionConfirmation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSnsTopicSubscriptionConfirmationCreate,
+		Read:   resourceAwsSnsTopicSubscriptionConfirmationRead,
+		Delete: resourceAwsSnsTopicSubscriptionConfirmationDelete,
+
+		Schema: map[string]*schema.Schema{
+			"topic_arn": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"token": &schema.Schema{
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				Sensitive: true,
+			},
+			"authenticate_on_unsubscribe": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"arn": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsSnsTopicSubscriptionConfirmationCreate(d *schema.ResourceData, meta interface{}) error {
+	snsconn := meta.(*AWSClient).snsconn
+
+	req := &sns.ConfirmSubscriptionInput{
+		TopicArn: aws.String(d.Get("topic_arn").(string)),
+		Token:    aws.String(d.Get("token").(string)),
+	}
+	if d.Get("authenticate_on_unsubscribe").(bool) {
+		req.AuthenticateOnUnsubscribe = aws.String("true")
+	}
+
+	log.Printf("[DEBUG] Confirming SNS subscription to topic %s", *req.TopicArn)
+
+	output, err := snsconn.ConfirmSubscription(req)
+	if err != nil {
+		return fmt.Errorf("Error confirming SNS subscription: %s", err)
+	}
+
+	d.SetId(*output.SubscriptionArn)
+	d.Set("arn", *output.SubscriptionArn)
+
+	return resourceAwsSnsTopicSubscriptionConfirmationRead(d, meta)
+}
+
+func resourceAwsSnsTopicSubscriptionConfirmationRead(d *schema.ResourceData, meta interface{}) error {
+	snsconn := meta.(*AWSClient).snsconn
+
+	_, err := snsconn.GetSubscriptionAttributes(&sns.GetSubscriptionAttributesInput{
+		SubscriptionArn: aws.String(d.Id()),
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceAwsSnsTopicSubscriptionConfirmationDelete(d *schema.ResourceData, meta interface{}) error {
+	// A confirmation can't be undone server-side; removing this resource
+	// just stops Terraform from tracking it. The underlying subscription is
+	// managed (and torn down) by aws_sns_topic_subscription.
+	return nil
+}
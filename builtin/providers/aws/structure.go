@@ -0,0 +1,52 @@
+package aws
+
+import (
+	"encoding/json"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// normalizeJsonString takes a JSON string and reserializes it with a
+// consistent (alphabetical) key order so that cosmetic differences such as
+// key ordering or whitespace don't register as a Terraform diff. If the
+// input isn't valid JSON, it's returned unmodified along with the parse
+// error so callers can surface it (e.g. from a ValidateFunc).
+func normalizeJsonString(jsonString interface{}) (string, error) {
+	var j interface{}
+
+	if jsonString == nil || jsonString.(string) == "" {
+		return "", nil
+	}
+
+	s := jsonString.(string)
+
+	err := json.Unmarshal([]byte(s), &j)
+	if err != nil {
+		return s, err
+	}
+
+	bytes, err := json.Marshal(j)
+	if err != nil {
+		return "", err
+	}
+
+	return string(bytes), nil
+}
+
+// suppressEquivalentJsonDiffs is a DiffSuppressFunc for JSON-valued
+// attributes: it compares the old and new values structurally instead of
+// byte-for-byte, so reformatting the same policy (key order, whitespace)
+// doesn't surface as a diff.
+func suppressEquivalentJsonDiffs(k, old, new string, d *schema.ResourceData) bool {
+	oldNormalized, err := normalizeJsonString(old)
+	if err != nil {
+		return false
+	}
+
+	newNormalized, err := normalizeJsonString(new)
+	if err != nil {
+		return false
+	}
+
+	return oldNormalized == newNormalized
+}